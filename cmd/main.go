@@ -1,17 +1,72 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os/signal"
+	"syscall"
+
 	"mercor/internal/db"
 	router "mercor/internal/domain/router"
+	"mercor/internal/logging"
+	"mercor/internal/server"
 
 	"github.com/gin-gonic/gin"
 )
 
 func main() {
-	database := db.Connect()
-	db.Seed(database)
+	dbCfg := db.LoadFromEnv()
+	database, err := db.Connect(dbCfg)
+	if err != nil {
+		log.Fatalf("failed to connect database: %v", err)
+	}
+
+	srvCfg := server.LoadFromEnv()
+	baseLogger := logging.NewFromEnv()
+
+	gin.DefaultWriter = logging.AccessLogWriter()
+	r := gin.New()
+	r.Use(logging.Middleware(baseLogger), gin.Logger(), gin.Recovery())
+	router.InitRoutes(r, database)
+
+	srv := &http.Server{
+		Addr:           fmt.Sprintf(":%s", srvCfg.Port),
+		Handler:        r,
+		ReadTimeout:    srvCfg.ReadTimeout,
+		WriteTimeout:   srvCfg.WriteTimeout,
+		IdleTimeout:    srvCfg.IdleTimeout,
+		MaxHeaderBytes: 1 << 20,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("server error: %v", err)
+		}
+	}()
+
+	<-ctx.Done()
+	stop()
+	log.Println("shutting down server")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), srvCfg.ShutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		log.Printf("server shutdown error: %v", err)
+	}
 
-	r := gin.Default()
-	router.InitRoutes(r)
-	r.Run(":8080")
+	sqlDB, err := database.DB()
+	if err != nil {
+		log.Printf("failed to get underlying sql.DB: %v", err)
+		return
+	}
+	if err := sqlDB.Close(); err != nil {
+		log.Printf("failed to close database: %v", err)
+	}
 }
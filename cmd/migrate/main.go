@@ -0,0 +1,79 @@
+// Command migrate manages the database schema independently of the
+// API server so deploys can run "migrate up" as an explicit step
+// rather than relying on AutoMigrate at server boot.
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"mercor/internal/db"
+
+	"gorm.io/gorm"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		log.Fatalf("usage: migrate <up|status|drop> [--yes]")
+	}
+
+	cfg := db.LoadFromEnv()
+	database, err := db.Connect(cfg)
+	if err != nil {
+		log.Fatalf("failed to connect database: %v", err)
+	}
+
+	switch os.Args[1] {
+	case "up":
+		if err := database.AutoMigrate(db.Models()...); err != nil {
+			log.Fatalf("migration failed: %v", err)
+		}
+		log.Println("Database migration complete")
+
+	case "status":
+		migrator := database.Migrator()
+		for _, model := range db.Models() {
+			if !migrator.HasTable(model) {
+				fmt.Printf("%T: table missing\n", model)
+				continue
+			}
+			fmt.Printf("%T: table present\n", model)
+
+			stmt := &gorm.Statement{DB: database}
+			if err := stmt.Parse(model); err != nil {
+				log.Printf("%T: failed to parse schema: %v", model, err)
+				continue
+			}
+			for _, field := range stmt.Schema.Fields {
+				if !migrator.HasColumn(model, field.DBName) {
+					fmt.Printf("%T: column %q missing\n", model, field.DBName)
+				}
+			}
+		}
+
+	case "drop":
+		if os.Getenv("APP_ENV") == "production" {
+			log.Fatalf("refusing to drop tables with APP_ENV=production")
+		}
+		if !hasFlag("--yes") {
+			log.Fatalf("drop requires --yes to confirm")
+		}
+		if err := database.Migrator().DropTable(db.Models()...); err != nil {
+			log.Fatalf("drop failed: %v", err)
+		}
+		log.Println("Dropped all managed tables")
+
+	default:
+		log.Fatalf("unknown subcommand %q, want up|status|drop", os.Args[1])
+	}
+}
+
+func hasFlag(name string) bool {
+	for _, arg := range os.Args[2:] {
+		if arg == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+// Command seed loads reference data into the database. It is run
+// explicitly rather than on every server boot so seeding stays an
+// intentional, observable step in deploys, and is safe to re-run since
+// every record is upserted by its natural key.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"mercor/internal/db"
+)
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "status" {
+		runStatus(os.Args[2:])
+		return
+	}
+	runSeed(os.Args[1:])
+}
+
+func runSeed(args []string) {
+	fs := flag.NewFlagSet("seed", flag.ExitOnError)
+	only := fs.String("only", "", "comma-separated list of seed groups to run (default: all)")
+	seedFile := fs.String("seed-file", "", "path to a JSON or YAML file of additional seed records")
+	fs.Parse(args)
+
+	cfg := db.LoadFromEnv()
+	database, err := db.Connect(cfg)
+	if err != nil {
+		log.Fatalf("failed to connect database: %v", err)
+	}
+
+	opts := db.SeedOptions{SeedFile: *seedFile}
+	if *only != "" {
+		opts.Only = strings.Split(*only, ",")
+	}
+
+	if err := db.Seed(database, opts); err != nil {
+		log.Fatalf("seed failed: %v", err)
+	}
+	log.Println("Database seed complete")
+}
+
+func runStatus(args []string) {
+	cfg := db.LoadFromEnv()
+	database, err := db.Connect(cfg)
+	if err != nil {
+		log.Fatalf("failed to connect database: %v", err)
+	}
+
+	counts, err := db.Status(database)
+	if err != nil {
+		log.Fatalf("seed status failed: %v", err)
+	}
+
+	for group, count := range counts {
+		fmt.Printf("%s: %d row(s)\n", group, count)
+	}
+}
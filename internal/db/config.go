@@ -0,0 +1,70 @@
+package db
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/joho/godotenv"
+)
+
+// Config holds the parameters needed to open a database connection.
+// It is populated from the environment so the same binary can run
+// against SQLite in development and Postgres in production.
+type Config struct {
+	Driver   string // "postgres" or "sqlite"
+	Host     string
+	Port     string
+	User     string
+	Password string
+	Name     string
+	SSLMode  string
+	TimeZone string
+
+	// AutoMigrate enables running AutoMigrate from Connect, which is only
+	// intended for local development. Production deploys migrate schema
+	// via cmd/migrate instead.
+	AutoMigrate bool
+}
+
+// LoadFromEnv builds a Config from environment variables, loading a
+// local .env file first if one is present. Missing values fall back
+// to development-friendly defaults.
+func LoadFromEnv() Config {
+	if err := godotenv.Load(); err != nil && !os.IsNotExist(err) {
+		log.Printf("failed to load .env file: %v", err)
+	}
+
+	return Config{
+		Driver:   getEnv("DB_DRIVER", "postgres"),
+		Host:     getEnv("DB_HOST", "localhost"),
+		Port:     getEnv("DB_PORT", "5432"),
+		User:     getEnv("DB_USER", "postgres"),
+		Password: getEnv("DB_PASSWORD", ""),
+		Name:     getEnv("DB_NAME", "mercortwo"),
+		SSLMode:  getEnv("DB_SSLMODE", "disable"),
+		TimeZone: getEnv("DB_TIMEZONE", "UTC"),
+
+		AutoMigrate: getEnv("DB_AUTO_MIGRATE", "false") == "true",
+	}
+}
+
+// DSN builds the Postgres connection string for this Config.
+func (c Config) DSN() string {
+	return fmt.Sprintf(
+		"host=%s user=%s password=%s dbname=%s port=%s sslmode=%s TimeZone=%s",
+		c.Host, c.User, c.Password, c.Name, c.Port, c.SSLMode, c.TimeZone,
+	)
+}
+
+// SQLitePath builds the file path used for the SQLite driver.
+func (c Config) SQLitePath() string {
+	return fmt.Sprintf("%s.db", c.Name)
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -1,32 +1,64 @@
 package db
 
 import (
-	"log"
+	"fmt"
 
 	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
 
 	jobs "mercor/internal/domain/jobs"
 	paymentLineItem "mercor/internal/domain/paymentLineItem"
 	timelog "mercor/internal/domain/timelog"
 
+	"mercor/internal/logging"
+
 	"gorm.io/gorm"
 )
 
-func Connect() *gorm.DB {
-	dsn := "host=localhost user=postgres password=Shruti@25 dbname=mercortwo port=5432 sslmode=disable"
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
-	if err != nil {
-		log.Fatalf("failed to connect database: %v", err)
-	}
-
-	err = db.AutoMigrate(
+// Models lists every type managed by schema migration. cmd/migrate and
+// the DB_AUTO_MIGRATE dev path both migrate off of this list so they
+// never drift apart.
+func Models() []interface{} {
+	return []interface{}{
 		&jobs.Job{},
 		&timelog.Timelog{},
 		&paymentLineItem.PaymentLineItem{},
+	}
+}
+
+// Connect opens a database connection according to cfg, selecting the
+// GORM dialector based on cfg.Driver ("postgres" or "sqlite"). Schema
+// migration only runs here when cfg.AutoMigrate is set; otherwise use
+// cmd/migrate.
+//
+// Queries are logged through logging.GormLogger, which pulls the
+// request-scoped slog.Logger off the context passed to db.WithContext
+// so SQL shares a request_id with the HTTP request that issued it.
+func Connect(cfg Config) (*gorm.DB, error) {
+	var (
+		db  *gorm.DB
+		err error
 	)
+
+	gormCfg := &gorm.Config{Logger: logging.NewGormLogger()}
+
+	switch cfg.Driver {
+	case "postgres":
+		db, err = gorm.Open(postgres.Open(cfg.DSN()), gormCfg)
+	case "sqlite":
+		db, err = gorm.Open(sqlite.Open(cfg.SQLitePath()), gormCfg)
+	default:
+		return nil, fmt.Errorf("db: unsupported driver %q", cfg.Driver)
+	}
 	if err != nil {
-		log.Fatalf("Auto migration failed: %v", err)
+		return nil, fmt.Errorf("failed to connect database: %w", err)
+	}
+
+	if cfg.AutoMigrate {
+		if err := db.AutoMigrate(Models()...); err != nil {
+			return nil, fmt.Errorf("auto migration failed: %w", err)
+		}
 	}
 
-	return db
+	return db, nil
 }
@@ -0,0 +1,32 @@
+package db
+
+import "testing"
+
+func TestConnect_SQLite(t *testing.T) {
+	cfg := Config{
+		Driver:      "sqlite",
+		Name:        t.TempDir() + "/connect-test",
+		AutoMigrate: true,
+	}
+
+	database, err := Connect(cfg)
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+
+	sqlDB, err := database.DB()
+	if err != nil {
+		t.Fatalf("database.DB() error = %v", err)
+	}
+	defer sqlDB.Close()
+
+	if err := sqlDB.Ping(); err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+
+	for _, model := range Models() {
+		if !database.Migrator().HasTable(model) {
+			t.Errorf("expected AutoMigrate to create a table for %T", model)
+		}
+	}
+}
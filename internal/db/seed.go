@@ -0,0 +1,200 @@
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	jobs "mercor/internal/domain/jobs"
+	paymentLineItem "mercor/internal/domain/paymentLineItem"
+	timelog "mercor/internal/domain/timelog"
+
+	"mercor/internal/logging"
+
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm"
+)
+
+// Seed describes a single reference-data record and the natural key
+// used to look it up, so seeding the same record twice is a no-op.
+type Seed struct {
+	Key    string
+	Value  string
+	Record interface{}
+}
+
+// SeedGroup is a named source of Seed entries. The name doubles as the
+// --only filter value.
+type SeedGroup struct {
+	Name  string
+	Seeds func() []Seed
+}
+
+// Registry lists every domain package that contributes seed data.
+func Registry() []SeedGroup {
+	return []SeedGroup{
+		{Name: "jobs", Seeds: jobs.Seeds},
+		{Name: "timelog", Seeds: timelog.Seeds},
+		{Name: "paymentLineItem", Seeds: paymentLineItem.Seeds},
+	}
+}
+
+// SeedOptions controls which groups Seed runs and where extra seed
+// data is loaded from.
+type SeedOptions struct {
+	Only     []string
+	SeedFile string
+}
+
+// Seed upserts reference data for the selected groups. Each record is
+// looked up by its natural key via FirstOrCreate, so re-running Seed
+// is always safe. Every insert/skip is logged as a structured event so
+// CI can assert on seed idempotency instead of parsing free-text logs.
+func Seed(database *gorm.DB, opts SeedOptions) error {
+	logger := logging.NewFromEnv()
+
+	for _, group := range Registry() {
+		if !groupSelected(group.Name, opts.Only) {
+			continue
+		}
+		for _, s := range group.Seeds() {
+			result := database.Where(fmt.Sprintf("%s = ?", s.Key), s.Value).FirstOrCreate(s.Record)
+			if result.Error != nil {
+				return fmt.Errorf("seed %s(%s=%s): %w", group.Name, s.Key, s.Value, result.Error)
+			}
+			logger.Info("seed", "action", seedAction(result.RowsAffected), "group", group.Name, "key", s.Key, "value", s.Value)
+		}
+	}
+
+	if opts.SeedFile != "" {
+		if err := seedFromFile(database, logger, opts.SeedFile); err != nil {
+			return fmt.Errorf("seed file %s: %w", opts.SeedFile, err)
+		}
+	}
+
+	return nil
+}
+
+// fileSeed is one record loaded from a --seed-file JSON or YAML document.
+type fileSeed struct {
+	Table string                 `json:"table" yaml:"table"`
+	Key   string                 `json:"key" yaml:"key"`
+	Value string                 `json:"value" yaml:"value"`
+	Data  map[string]interface{} `json:"data" yaml:"data"`
+}
+
+func seedFromFile(database *gorm.DB, logger *slog.Logger, path string) error {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var records []fileSeed
+	if strings.HasSuffix(path, ".yaml") || strings.HasSuffix(path, ".yml") {
+		err = yaml.Unmarshal(raw, &records)
+	} else {
+		err = json.Unmarshal(raw, &records)
+	}
+	if err != nil {
+		return err
+	}
+
+	allowed, err := tableColumns(database)
+	if err != nil {
+		return fmt.Errorf("resolve seed-file schema: %w", err)
+	}
+
+	for _, r := range records {
+		columns, ok := allowed[r.Table]
+		if !ok {
+			return fmt.Errorf("seed file: unknown table %q", r.Table)
+		}
+		if !columns[r.Key] {
+			return fmt.Errorf("seed file: unknown column %q on table %q", r.Key, r.Table)
+		}
+
+		if r.Data == nil {
+			r.Data = map[string]interface{}{}
+		}
+		r.Data[r.Key] = r.Value
+
+		// FirstOrCreate doesn't support a bare map destination (it
+		// needs a model to resolve the primary key and table), so the
+		// lookup and insert are done as two explicit steps instead.
+		var existing int64
+		if err := database.Table(r.Table).Where(fmt.Sprintf("%s = ?", r.Key), r.Value).Count(&existing).Error; err != nil {
+			return err
+		}
+
+		action := "skip"
+		if existing == 0 {
+			if err := database.Table(r.Table).Create(&r.Data).Error; err != nil {
+				return err
+			}
+			action = "insert"
+		}
+		logger.Info("seed", "action", action, "group", "file", "table", r.Table, "key", r.Key, "value", r.Value)
+	}
+
+	return nil
+}
+
+// tableColumns returns, for every table managed by Models, the set of
+// valid column names, so seedFromFile can validate a seed file's
+// table/key fields against real schema before splicing them into a
+// WHERE clause — seed files are external input and must not be
+// trusted to name their own columns.
+func tableColumns(database *gorm.DB) (map[string]map[string]bool, error) {
+	allowed := make(map[string]map[string]bool)
+	for _, model := range Models() {
+		stmt := &gorm.Statement{DB: database}
+		if err := stmt.Parse(model); err != nil {
+			return nil, fmt.Errorf("parse schema for %T: %w", model, err)
+		}
+
+		columns := make(map[string]bool, len(stmt.Schema.Fields))
+		for _, field := range stmt.Schema.Fields {
+			columns[field.DBName] = true
+		}
+		allowed[stmt.Schema.Table] = columns
+	}
+	return allowed, nil
+}
+
+// Status reports how many rows currently exist for each seeded group.
+func Status(database *gorm.DB) (map[string]int64, error) {
+	counts := make(map[string]int64)
+	for _, group := range Registry() {
+		seeds := group.Seeds()
+		if len(seeds) == 0 {
+			continue
+		}
+		var count int64
+		if err := database.Model(seeds[0].Record).Count(&count).Error; err != nil {
+			return nil, fmt.Errorf("status %s: %w", group.Name, err)
+		}
+		counts[group.Name] = count
+	}
+	return counts, nil
+}
+
+func groupSelected(name string, only []string) bool {
+	if len(only) == 0 {
+		return true
+	}
+	for _, o := range only {
+		if strings.EqualFold(o, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func seedAction(rowsAffected int64) string {
+	if rowsAffected > 0 {
+		return "insert"
+	}
+	return "skip"
+}
@@ -0,0 +1,73 @@
+package db
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gorm.io/gorm"
+)
+
+func TestSeed_SeedFileIsIdempotent(t *testing.T) {
+	cfg := Config{
+		Driver:      "sqlite",
+		Name:        filepath.Join(t.TempDir(), "seed-test"),
+		AutoMigrate: true,
+	}
+	database, err := Connect(cfg)
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+
+	model := Models()[0]
+	stmt := &gorm.Statement{DB: database}
+	if err := stmt.Parse(model); err != nil {
+		t.Fatalf("parse schema for %T: %v", model, err)
+	}
+	tableName := stmt.Schema.Table
+	if stmt.Schema.PrioritizedPrimaryField == nil {
+		t.Fatalf("%T has no primary field to seed against", model)
+	}
+	key := stmt.Schema.PrioritizedPrimaryField.DBName
+
+	records := []fileSeed{
+		{Table: tableName, Key: key, Value: "1"},
+	}
+	raw, err := json.Marshal(records)
+	if err != nil {
+		t.Fatalf("marshal seed file: %v", err)
+	}
+
+	seedFile := filepath.Join(t.TempDir(), "seed.json")
+	if err := os.WriteFile(seedFile, raw, 0o644); err != nil {
+		t.Fatalf("write seed file: %v", err)
+	}
+
+	// Only names a group that doesn't exist, so the in-code registry
+	// groups are skipped and just the --seed-file path is exercised.
+	opts := SeedOptions{SeedFile: seedFile, Only: []string{"none"}}
+
+	if err := Seed(database, opts); err != nil {
+		t.Fatalf("first Seed() error = %v", err)
+	}
+	var firstCount int64
+	if err := database.Table(tableName).Count(&firstCount).Error; err != nil {
+		t.Fatalf("count after first seed: %v", err)
+	}
+	if firstCount == 0 {
+		t.Fatalf("expected seed file to insert a row, got count = 0")
+	}
+
+	if err := Seed(database, opts); err != nil {
+		t.Fatalf("second Seed() error = %v", err)
+	}
+	var secondCount int64
+	if err := database.Table(tableName).Count(&secondCount).Error; err != nil {
+		t.Fatalf("count after second seed: %v", err)
+	}
+
+	if firstCount != secondCount {
+		t.Errorf("seed file is not idempotent: first=%d second=%d", firstCount, secondCount)
+	}
+}
@@ -0,0 +1,49 @@
+// Package router wires the API's HTTP routes onto a gin.Engine.
+package router
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// InitRoutes registers the API's routes on r, including the
+// liveness/readiness probes deploy tooling polls before routing
+// traffic to a new instance.
+func InitRoutes(r *gin.Engine, database *gorm.DB) {
+	r.GET("/healthz", healthz)
+	r.GET("/readyz", readyz(database))
+}
+
+// healthz reports that the process is up, without checking any
+// dependency, so orchestrators can distinguish "process alive" from
+// "process ready to serve".
+func healthz(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// readyz reports whether the service is ready to serve traffic by
+// pinging the database, so a deploy doesn't get routed requests
+// before its DB connection is actually usable.
+func readyz(database *gorm.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		sqlDB, err := database.DB()
+		if err != nil {
+			c.JSON(http.StatusServiceUnavailable, gin.H{"status": "not ready", "error": err.Error()})
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), 2*time.Second)
+		defer cancel()
+
+		if err := sqlDB.PingContext(ctx); err != nil {
+			c.JSON(http.StatusServiceUnavailable, gin.H{"status": "not ready", "error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"status": "ready"})
+	}
+}
@@ -0,0 +1,83 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// GormLogger adapts the request-scoped slog.Logger pulled off a query's
+// context (via db.WithContext) into gorm's logger.Interface, so every
+// SQL statement is emitted with the same request_id as the HTTP
+// request that triggered it.
+type GormLogger struct {
+	SlowThreshold             time.Duration
+	IgnoreRecordNotFoundError bool
+	level                     gormlogger.LogLevel
+}
+
+// NewGormLogger builds a GormLogger at gorm's default "warn" level,
+// logging queries slower than 200ms and swallowing record-not-found
+// errors as those are routine control flow, not failures.
+func NewGormLogger() *GormLogger {
+	return &GormLogger{
+		SlowThreshold:             200 * time.Millisecond,
+		IgnoreRecordNotFoundError: true,
+		level:                     gormlogger.Warn,
+	}
+}
+
+// LogMode returns a copy of l at the given level, per gorm's
+// logger.Interface contract.
+func (l *GormLogger) LogMode(level gormlogger.LogLevel) gormlogger.Interface {
+	newLogger := *l
+	newLogger.level = level
+	return &newLogger
+}
+
+func (l *GormLogger) Info(ctx context.Context, msg string, args ...interface{}) {
+	if l.level >= gormlogger.Info {
+		FromContext(ctx).Info(msg, "args", args)
+	}
+}
+
+func (l *GormLogger) Warn(ctx context.Context, msg string, args ...interface{}) {
+	if l.level >= gormlogger.Warn {
+		FromContext(ctx).Warn(msg, "args", args)
+	}
+}
+
+func (l *GormLogger) Error(ctx context.Context, msg string, args ...interface{}) {
+	if l.level >= gormlogger.Error {
+		FromContext(ctx).Error(msg, "args", args)
+	}
+}
+
+// Trace logs the SQL statement produced by fc along with its duration
+// and rows affected, escalating to Error on a real query error, to
+// Warn above SlowThreshold, and to Debug otherwise. The Debug case
+// only runs at the Info level, so at the default Warn level ordinary
+// statements are not logged.
+func (l *GormLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	if l.level <= gormlogger.Silent {
+		return
+	}
+
+	elapsed := time.Since(begin)
+	sql, rows := fc()
+	logger := FromContext(ctx)
+
+	switch {
+	case err != nil && l.level >= gormlogger.Error &&
+		!(l.IgnoreRecordNotFoundError && errors.Is(err, gorm.ErrRecordNotFound)):
+		logger.Error("sql", "sql", sql, "rows", rows, "duration", elapsed, "error", err)
+	case l.SlowThreshold != 0 && elapsed > l.SlowThreshold && l.level >= gormlogger.Warn:
+		logger.Warn("slow sql", "sql", sql, "rows", rows, "duration", elapsed)
+	case l.level >= gormlogger.Info:
+		logger.Debug("sql", "sql", sql, "rows", rows, "duration", elapsed)
+	}
+}
@@ -0,0 +1,52 @@
+// Package logging provides the slog.Logger used across the API and
+// GORM so an HTTP request and the SQL it issues share a request_id.
+package logging
+
+import (
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// New builds a slog.Logger writing to w, using a JSON handler when
+// format is "json" and a human-readable text handler otherwise.
+func New(format, level string, w *os.File) *slog.Logger {
+	opts := &slog.HandlerOptions{Level: parseLevel(level)}
+
+	var handler slog.Handler
+	if strings.EqualFold(format, "json") {
+		handler = slog.NewJSONHandler(w, opts)
+	} else {
+		handler = slog.NewTextHandler(w, opts)
+	}
+
+	return slog.New(handler)
+}
+
+// NewFromEnv builds a Logger from LOG_FORMAT and LOG_LEVEL, defaulting
+// to text output at info level for local development.
+func NewFromEnv() *slog.Logger {
+	format := getEnv("LOG_FORMAT", "text")
+	level := getEnv("LOG_LEVEL", "info")
+	return New(format, level, os.Stdout)
+}
+
+func parseLevel(level string) slog.Level {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,64 @@
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+type contextKey struct{}
+
+var loggerKey contextKey
+
+const requestIDHeader = "X-Request-ID"
+
+// ginLoggerKey is the gin.Context key the request-scoped logger is
+// stored under.
+const ginLoggerKey = "logger"
+
+// Middleware injects a per-request slog.Logger carrying a request_id
+// into both the gin.Context and the request's stdlib context, so the
+// same logger can be retrieved from handlers (via c.Get(ginLoggerKey)
+// or FromContext(c.Request.Context())) and from GORM (via FromContext
+// on db.WithContext's context).
+func Middleware(base *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(requestIDHeader)
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+
+		reqLogger := base.With("request_id", requestID)
+		c.Header(requestIDHeader, requestID)
+		c.Set(ginLoggerKey, reqLogger)
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), loggerKey, reqLogger))
+
+		c.Next()
+	}
+}
+
+// FromContext returns the request-scoped logger, falling back to
+// slog.Default if none was injected (e.g. outside a request).
+func FromContext(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(loggerKey).(*slog.Logger); ok {
+		return logger
+	}
+	return slog.Default()
+}
+
+// AccessLogWriter tees Gin's access log to stdout and a rotating
+// gin.log file so deploys keep a local history without unbounded disk
+// growth.
+func AccessLogWriter() io.Writer {
+	return io.MultiWriter(os.Stdout, &lumberjack.Logger{
+		Filename:   "gin.log",
+		MaxSize:    100,
+		MaxBackups: 5,
+		MaxAge:     28,
+	})
+}
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+// Config holds the tunables for the HTTP server, all overridable via
+// the environment so production can set stricter timeouts than local
+// development.
+type Config struct {
+	Port            string
+	ReadTimeout     time.Duration
+	WriteTimeout    time.Duration
+	IdleTimeout     time.Duration
+	ShutdownTimeout time.Duration
+}
+
+// LoadFromEnv builds a Config from environment variables, falling
+// back to conservative defaults when unset.
+func LoadFromEnv() Config {
+	return Config{
+		Port:            getEnv("HTTP_PORT", "8080"),
+		ReadTimeout:     getDuration("READ_TIMEOUT", 10*time.Second),
+		WriteTimeout:    getDuration("WRITE_TIMEOUT", 10*time.Second),
+		IdleTimeout:     getDuration("IDLE_TIMEOUT", 60*time.Second),
+		ShutdownTimeout: getDuration("SHUTDOWN_TIMEOUT", 15*time.Second),
+	}
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func getDuration(key string, fallback time.Duration) time.Duration {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	seconds, err := strconv.Atoi(v)
+	if err != nil {
+		return fallback
+	}
+	return time.Duration(seconds) * time.Second
+}